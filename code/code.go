@@ -6,19 +6,51 @@ import (
 	"bytes"
 	"fmt"
 	"path/filepath"
+	"sort"
+	"strings"
 
 	"github.com/alecthomas/chroma/quick"
+	"github.com/charmbracelet/bubbles/key"
 	"github.com/charmbracelet/bubbles/viewport"
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/charmbracelet/lipgloss"
 	"github.com/mistakenelf/teacup/dirfs"
+	"github.com/muesli/reflow/ansi"
+	"github.com/muesli/reflow/truncate"
+	"github.com/muesli/reflow/wrap"
 )
 
-type syntaxMsg string
-type errorMsg error
+type syntaxMsg struct {
+	load    int
+	content string
+}
+type errorMsg struct {
+	load int
+	err  error
+}
 
 const (
-	padding = 1
+	padding       = 1
+	markGutter    = "▍ "
+	noGutter      = "  "
+	resetSequence = "\x1b[0m"
+)
+
+var (
+	nextMarkKey     = key.NewBinding(key.WithKeys("]"), key.WithHelp("]", "next mark"))
+	previousMarkKey = key.NewBinding(key.WithKeys("["), key.WithHelp("[", "previous mark"))
+	markStyle       = lipgloss.NewStyle().Foreground(lipgloss.AdaptiveColor{Light: "#F25D94", Dark: "#F25D94"})
+
+	longLineMarkerStyle = lipgloss.NewStyle().Faint(true).Italic(true)
+)
+
+// LongLinePolicy represents how lines longer than the long line threshold are handled.
+type LongLinePolicy int
+
+// Available long line policies.
+const (
+	TruncateLongLines LongLinePolicy = iota
+	WrapLongLines
 )
 
 // Highlight returns a syntax highlighted string of text.
@@ -31,20 +63,40 @@ func Highlight(content, extension, syntaxTheme string) (string, error) {
 	return buf.String(), nil
 }
 
-// readFileContentCmd reads the content of the file.
-func readFileContentCmd(fileName, syntaxTheme string) tea.Cmd {
+// limitLongLine truncates or wraps a highlighted line which is wider than
+// threshold based on the policy, a threshold of zero or less disables this.
+func limitLongLine(line string, threshold int, policy LongLinePolicy) string {
+	width := ansi.PrintableRuneWidth(line)
+	if threshold <= 0 || width <= threshold {
+		return line
+	}
+
+	switch policy {
+	case TruncateLongLines:
+		return truncate.String(line, uint(threshold)) + resetSequence +
+			longLineMarkerStyle.Render(fmt.Sprintf("… (line continues, %d chars)", width-threshold))
+	case WrapLongLines:
+		return wrap.String(line, threshold)
+	}
+
+	return line
+}
+
+// readFileContentCmd reads the content of the file, tagging the result with
+// the load it belongs to so that results of superseded loads can be ignored.
+func readFileContentCmd(fileName, syntaxTheme string, load int) tea.Cmd {
 	return func() tea.Msg {
 		content, err := dirfs.ReadFileContent(fileName)
 		if err != nil {
-			return errorMsg(err)
+			return errorMsg{load: load, err: err}
 		}
 
 		highlightedContent, err := Highlight(content, filepath.Ext(fileName), syntaxTheme)
 		if err != nil {
-			return errorMsg(err)
+			return errorMsg{load: load, err: err}
 		}
 
-		return syntaxMsg(highlightedContent)
+		return syntaxMsg{load: load, content: highlightedContent}
 	}
 }
 
@@ -57,6 +109,14 @@ type Model struct {
 	Filename           string
 	HighlightedContent string
 	SyntaxTheme        string
+	Marks              map[int]bool
+	LongLineThreshold  int
+	LongLinePolicy     LongLinePolicy
+	ready              bool
+	content            string
+	currentMark        int
+	lineOffsets        []int
+	load               int
 }
 
 // New creates a new instance of code.
@@ -80,6 +140,8 @@ func New(active, borderless bool, borderColor lipgloss.AdaptiveColor) Model {
 		Active:      active,
 		BorderColor: borderColor,
 		SyntaxTheme: "dracula",
+		Marks:       map[int]bool{},
+		currentMark: -1,
 	}
 }
 
@@ -91,8 +153,29 @@ func (m Model) Init() tea.Cmd {
 // SetFileName sets current file to highlight.
 func (m *Model) SetFileName(filename string) tea.Cmd {
 	m.Filename = filename
+	m.ready = false
+	m.load++
+
+	return readFileContentCmd(filename, m.SyntaxTheme, m.load)
+}
+
+// SetLongLineThreshold sets the width after which lines are truncated or wrapped
+// based on the long line policy, zero disables the threshold.
+func (m *Model) SetLongLineThreshold(threshold int) {
+	m.LongLineThreshold = threshold
+	m.updateContent()
+}
+
+// SetLongLinePolicy sets how lines longer than the long line threshold are handled.
+func (m *Model) SetLongLinePolicy(policy LongLinePolicy) {
+	m.LongLinePolicy = policy
+	m.updateContent()
+}
 
-	return readFileContentCmd(filename, m.SyntaxTheme)
+// IsReady returns false while a file is being loaded and
+// true once its content, or an error loading it, has been set.
+func (m Model) IsReady() bool {
+	return m.ready
 }
 
 // SetIsActive sets if the bubble is currently active.
@@ -100,6 +183,22 @@ func (m *Model) SetIsActive(active bool) {
 	m.Active = active
 }
 
+// Focus focuses the bubble so that it handles key presses.
+func (m *Model) Focus() {
+	m.Active = true
+}
+
+// Blur blurs the bubble so that key presses are ignored,
+// file content is still updated while blurred.
+func (m *Model) Blur() {
+	m.Active = false
+}
+
+// Focused returns if the bubble is currently focused.
+func (m Model) Focused() bool {
+	return m.Active
+}
+
 // SetBorderColor sets the current color of the border.
 func (m *Model) SetBorderColor(color lipgloss.AdaptiveColor) {
 	m.BorderColor = color
@@ -120,10 +219,125 @@ func (m *Model) SetSize(w, h int) {
 	m.Viewport.Width = w
 	m.Viewport.Height = h
 
-	m.Viewport.SetContent(lipgloss.NewStyle().
-		Width(m.Viewport.Width).
-		Height(m.Viewport.Height).
-		Render(m.HighlightedContent))
+	m.updateContent()
+}
+
+// updateContent renders the content to the size of the viewport, leaving room for the
+// mark gutter, and records the first rendered line of each line of the content.
+func (m *Model) updateContent() {
+	lineStyle := lipgloss.NewStyle().Width(m.Viewport.Width - lipgloss.Width(noGutter))
+	lines := strings.Split(strings.TrimSuffix(m.content, "\n"), "\n")
+	rendered := make([]string, 0, len(lines))
+
+	m.lineOffsets = make([]int, len(lines))
+
+	for i, line := range lines {
+		m.lineOffsets[i] = len(rendered)
+		line = limitLongLine(line, m.LongLineThreshold, m.LongLinePolicy)
+		rendered = append(rendered, strings.Split(lineStyle.Render(line), "\n")...)
+	}
+
+	m.HighlightedContent = strings.Join(rendered, "\n")
+	m.Viewport.SetContent(m.renderMarks())
+}
+
+// markRow returns the rendered line on which the given line of the content starts.
+func (m Model) markRow(line int) int {
+	if line < 0 || line >= len(m.lineOffsets) {
+		return 0
+	}
+
+	return m.lineOffsets[line]
+}
+
+// renderMarks prefixes the highlighted content with a gutter indicating marked lines.
+func (m Model) renderMarks() string {
+	markedRows := make(map[int]bool, len(m.Marks))
+	for line := range m.Marks {
+		markedRows[m.markRow(line)] = true
+	}
+
+	lines := strings.Split(m.HighlightedContent, "\n")
+	for i, line := range lines {
+		if markedRows[i] {
+			lines[i] = markStyle.Render(markGutter) + line
+		} else {
+			lines[i] = noGutter + line
+		}
+	}
+
+	return strings.Join(lines, "\n")
+}
+
+// SetMark marks the given zero based line so that it can be jumped to.
+func (m *Model) SetMark(line int) {
+	if line < 0 || line >= len(m.lineOffsets) {
+		return
+	}
+
+	if m.Marks == nil {
+		m.Marks = map[int]bool{}
+	}
+
+	m.Marks[line] = true
+	m.Viewport.SetContent(m.renderMarks())
+}
+
+// ClearMark removes the mark from the given zero based line.
+func (m *Model) ClearMark(line int) {
+	delete(m.Marks, line)
+	m.Viewport.SetContent(m.renderMarks())
+}
+
+// sortedMarks returns the marked lines in ascending order.
+func (m Model) sortedMarks() []int {
+	marks := make([]int, 0, len(m.Marks))
+	for line := range m.Marks {
+		marks = append(marks, line)
+	}
+
+	sort.Ints(marks)
+
+	return marks
+}
+
+// markPosition returns the rendered line marks are navigated relative to, this is the top
+// of the viewport unless it is at the bottom and the last jumped to mark is below its top.
+func (m Model) markPosition() int {
+	if m.currentMark >= 0 && m.Viewport.AtBottom() && m.markRow(m.currentMark) > m.Viewport.YOffset {
+		return m.markRow(m.currentMark)
+	}
+
+	return m.Viewport.YOffset
+}
+
+// NextMark scrolls the viewport to the first mark after the current position.
+func (m *Model) NextMark() {
+	position := m.markPosition()
+
+	for _, line := range m.sortedMarks() {
+		if m.markRow(line) > position {
+			m.currentMark = line
+			m.Viewport.SetYOffset(m.markRow(line))
+
+			return
+		}
+	}
+}
+
+// PreviousMark scrolls the viewport to the last mark before the current position.
+func (m *Model) PreviousMark() {
+	position := m.markPosition()
+	marks := m.sortedMarks()
+
+	for i := len(marks) - 1; i >= 0; i-- {
+		if m.markRow(marks[i]) < position {
+			m.currentMark = marks[i]
+			m.Viewport.SetYOffset(m.markRow(marks[i]))
+
+			return
+		}
+	}
 }
 
 // GotoTop jumps to the top of the viewport.
@@ -140,27 +354,47 @@ func (m Model) Update(msg tea.Msg) (Model, tea.Cmd) {
 
 	switch msg := msg.(type) {
 	case syntaxMsg:
-		m.Filename = ""
-		m.HighlightedContent = lipgloss.NewStyle().
-			Width(m.Viewport.Width).
-			Height(m.Viewport.Height).
-			Render(string(msg))
+		if msg.load != m.load {
+			return m, nil
+		}
+
+		m.content = msg.content
+		m.Marks = map[int]bool{}
+		m.currentMark = -1
+		m.ready = true
 
-		m.Viewport.SetContent(m.HighlightedContent)
+		m.updateContent()
 
 		return m, nil
 	case errorMsg:
+		if msg.load != m.load {
+			return m, nil
+		}
+
 		m.Filename = ""
-		m.HighlightedContent = lipgloss.NewStyle().
-			Width(m.Viewport.Width).
-			Height(m.Viewport.Height).
-			Render("Error: " + msg.Error())
+		m.content = "Error: " + msg.err.Error()
+		m.Marks = map[int]bool{}
+		m.currentMark = -1
+		m.ready = true
 
-		m.Viewport.SetContent(m.HighlightedContent)
+		m.updateContent()
 
 		return m, nil
 	}
 
+	if msg, ok := msg.(tea.KeyMsg); ok && m.Active {
+		switch {
+		case key.Matches(msg, nextMarkKey):
+			m.NextMark()
+
+			return m, nil
+		case key.Matches(msg, previousMarkKey):
+			m.PreviousMark()
+
+			return m, nil
+		}
+	}
+
 	if m.Active {
 		m.Viewport, cmd = m.Viewport.Update(msg)
 		cmds = append(cmds, cmd)
@@ -454,6 +454,86 @@ func CopyDirectory(name string) error {
 	return errors.Unwrap(err)
 }
 
+// CopyDirectoryItem copies a file or directory to the destination provided,
+// failing if the destination already exists.
+func CopyDirectoryItem(src, dst string) error {
+	info, err := os.Stat(src)
+	if err != nil {
+		return fmt.Errorf("%w", err)
+	}
+
+	if _, err := os.Stat(dst); err == nil {
+		return fmt.Errorf("%s: %w", dst, os.ErrExist)
+	}
+
+	if !info.IsDir() {
+		return copyFileTo(src, dst, info.Mode())
+	}
+
+	if strings.HasPrefix(filepath.Clean(dst), filepath.Clean(src)+string(os.PathSeparator)) {
+		return fmt.Errorf("cannot copy %s into itself: %w", src, os.ErrInvalid)
+	}
+
+	err = filepath.WalkDir(src, func(path string, entry os.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+
+		relPath, err := filepath.Rel(src, path)
+		if err != nil {
+			return fmt.Errorf("%w", err)
+		}
+
+		target := filepath.Join(dst, relPath)
+
+		entryInfo, err := entry.Info()
+		if err != nil {
+			return fmt.Errorf("%w", err)
+		}
+
+		if entry.IsDir() {
+			if err := os.MkdirAll(target, entryInfo.Mode().Perm()); err != nil {
+				return fmt.Errorf("%w", err)
+			}
+
+			return nil
+		}
+
+		return copyFileTo(path, target, entryInfo.Mode())
+	})
+
+	return err
+}
+
+// copyFileTo copies the content of a file to the destination with the given mode.
+func copyFileTo(src, dst string, mode fs.FileMode) error {
+	srcFile, err := os.Open(filepath.Clean(src))
+	if err != nil {
+		return fmt.Errorf("%w", err)
+	}
+
+	defer func() {
+		_ = srcFile.Close()
+	}()
+
+	destFile, err := os.OpenFile(filepath.Clean(dst), os.O_CREATE|os.O_WRONLY|os.O_EXCL, mode.Perm())
+	if err != nil {
+		return fmt.Errorf("%w", err)
+	}
+
+	if _, err = io.Copy(destFile, srcFile); err != nil {
+		_ = destFile.Close()
+
+		return fmt.Errorf("%w", err)
+	}
+
+	if err = destFile.Close(); err != nil {
+		return fmt.Errorf("%w", err)
+	}
+
+	return nil
+}
+
 // GetDirectoryItemSize calculates the size of a directory or file.
 func GetDirectoryItemSize(path string) (int64, error) {
 	curFile, err := os.Stat(path)
@@ -0,0 +1,28 @@
+package dirfs
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// ErrInvalidFilename is returned when a filename is not valid for the current OS.
+var ErrInvalidFilename = errors.New("invalid filename")
+
+// IsValidFilename returns an error describing why a name
+// cannot be used as a filename on the current OS.
+func IsValidFilename(name string) error {
+	if name == "" {
+		return fmt.Errorf("%w: name cannot be empty", ErrInvalidFilename)
+	}
+
+	if name == CurrentDirectory || name == PreviousDirectory {
+		return fmt.Errorf("%w: %q is reserved", ErrInvalidFilename, name)
+	}
+
+	if index := strings.IndexAny(name, invalidFilenameChars); index != -1 {
+		return fmt.Errorf("%w: %q cannot contain %q", ErrInvalidFilename, name, name[index])
+	}
+
+	return validatePlatformFilename(name)
+}
@@ -0,0 +1,12 @@
+//go:build !windows
+
+package dirfs
+
+// invalidFilenameChars are the characters which cannot appear in a filename.
+const invalidFilenameChars = "/\x00"
+
+// validatePlatformFilename applies platform specific filename rules,
+// of which there are none beyond the invalid characters.
+func validatePlatformFilename(name string) error {
+	return nil
+}
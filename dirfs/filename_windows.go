@@ -0,0 +1,37 @@
+//go:build windows
+
+package dirfs
+
+import (
+	"fmt"
+	"strings"
+)
+
+// invalidFilenameChars are the characters which cannot appear in a filename on windows.
+const invalidFilenameChars = "<>:\"/\\|?*\x00\x01\x02\x03\x04\x05\x06\x07\x08\x09\x0a\x0b\x0c\x0d\x0e\x0f" +
+	"\x10\x11\x12\x13\x14\x15\x16\x17\x18\x19\x1a\x1b\x1c\x1d\x1e\x1f"
+
+// reservedFilenames are device names which cannot be used as a filename on windows,
+// regardless of any extensions following them.
+var reservedFilenames = map[string]bool{
+	"CON": true, "PRN": true, "AUX": true, "NUL": true,
+	"COM1": true, "COM2": true, "COM3": true, "COM4": true, "COM5": true,
+	"COM6": true, "COM7": true, "COM8": true, "COM9": true,
+	"LPT1": true, "LPT2": true, "LPT3": true, "LPT4": true, "LPT5": true,
+	"LPT6": true, "LPT7": true, "LPT8": true, "LPT9": true,
+	"CONIN$": true, "CONOUT$": true,
+}
+
+// validatePlatformFilename applies the windows specific filename rules.
+func validatePlatformFilename(name string) error {
+	if strings.HasSuffix(name, " ") || strings.HasSuffix(name, ".") {
+		return fmt.Errorf("%w: %q cannot end with a space or period", ErrInvalidFilename, name)
+	}
+
+	baseName, _, _ := strings.Cut(name, ".")
+	if reservedFilenames[strings.ToUpper(strings.TrimRight(baseName, " "))] {
+		return fmt.Errorf("%w: %q is a reserved name", ErrInvalidFilename, name)
+	}
+
+	return nil
+}
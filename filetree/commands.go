@@ -17,6 +17,38 @@ type errorMsg error
 type copyToClipboardMsg string
 type editorFinishedMsg struct{ err error }
 
+// Operation represents a filesystem operation performed by the filetree.
+type Operation int
+
+// Operations which are reported through an OperationResultMsg.
+const (
+	CreateFileOperation Operation = iota
+	CreateDirectoryOperation
+	DeleteOperation
+	MoveOperation
+	RenameOperation
+	CopyOperation
+)
+
+// OperationResultMsg is sent once a create, delete, move, rename or pasted copy has completed.
+// Path and Destination are always absolute, Destination is only set for moves and renames.
+type OperationResultMsg struct {
+	Operation   Operation
+	Path        string
+	Destination string
+	Err         error
+}
+
+// absolutePath returns the absolute form of path, falling back to path if it cannot be resolved.
+func absolutePath(path string) string {
+	absPath, err := filepath.Abs(path)
+	if err != nil {
+		return path
+	}
+
+	return absPath
+}
+
 // getDirectoryListingCmd updates the directory listing based on the name of the directory provided.
 func getDirectoryListingCmd(directoryName string, showHidden, showIcons bool) tea.Cmd {
 	return func() tea.Msg {
@@ -77,13 +109,35 @@ func getDirectoryListingCmd(directoryName string, showHidden, showIcons bool) te
 				fileInfo.Mode().String(),
 				ConvertBytesToSizeString(fileInfo.Size()))
 
+			fileName := filepath.Join(workingDirectory, file.Name())
+			isSymlink := fileInfo.Mode()&os.ModeSymlink != 0
+
+			var (
+				symlinkTarget   string
+				isBrokenSymlink bool
+			)
+
+			if isSymlink {
+				symlinkTarget, _ = os.Readlink(fileName)
+
+				if _, err := os.Stat(fileName); err != nil {
+					isBrokenSymlink = true
+					status = fmt.Sprintf("%s broken symlink -> %s", status, symlinkTarget)
+				} else {
+					status = fmt.Sprintf("%s -> %s", status, symlinkTarget)
+				}
+			}
+
 			items = append(items, Item{
 				title:            file.Name(),
 				desc:             status,
 				shortName:        file.Name(),
-				fileName:         filepath.Join(workingDirectory, file.Name()),
+				fileName:         fileName,
 				extension:        filepath.Ext(fileInfo.Name()),
 				isDirectory:      fileInfo.IsDir(),
+				isSymlink:        isSymlink,
+				isBrokenSymlink:  isBrokenSymlink,
+				symlinkTarget:    symlinkTarget,
 				currentDirectory: workingDirectory,
 				fileInfo:         fileInfo,
 				showIcons:        showIcons,
@@ -102,33 +156,43 @@ func moveItemCmd(path, name string) tea.Cmd {
 			return errorMsg(err)
 		}
 
-		if err := dirfs.MoveDirectoryItem(path, fmt.Sprintf("%s/%s", workingDir, name)); err != nil {
+		destination := filepath.Join(workingDir, name)
+		err = dirfs.MoveDirectoryItem(path, destination)
+
+		return OperationResultMsg{Operation: MoveOperation, Path: absolutePath(path), Destination: destination, Err: err}
+	}
+}
+
+// pasteCopyCmd copies a file or directory into the current directory.
+func pasteCopyCmd(path, name string) tea.Cmd {
+	return func() tea.Msg {
+		workingDir, err := dirfs.GetWorkingDirectory()
+		if err != nil {
 			return errorMsg(err)
 		}
 
-		return nil
+		destination := filepath.Join(workingDir, name)
+		err = dirfs.CopyDirectoryItem(path, destination)
+
+		return OperationResultMsg{Operation: CopyOperation, Path: absolutePath(path), Destination: destination, Err: err}
 	}
 }
 
 // createFileCmd creates a file based on the name provided.
 func createFileCmd(name string) tea.Cmd {
 	return func() tea.Msg {
-		if err := dirfs.CreateFile(name); err != nil {
-			return errorMsg(err)
-		}
+		err := dirfs.CreateFile(name)
 
-		return nil
+		return OperationResultMsg{Operation: CreateFileOperation, Path: absolutePath(name), Err: err}
 	}
 }
 
 // createDirectoryCmd creates a directory based on the name provided.
 func createDirectoryCmd(name string) tea.Cmd {
 	return func() tea.Msg {
-		if err := dirfs.CreateDirectory(name); err != nil {
-			return errorMsg(err)
-		}
+		err := dirfs.CreateDirectory(name)
 
-		return nil
+		return OperationResultMsg{Operation: CreateDirectoryOperation, Path: absolutePath(name), Err: err}
 	}
 }
 
@@ -137,20 +201,16 @@ func deleteItemCmd(name string) tea.Cmd {
 	return func() tea.Msg {
 		fileInfo, err := os.Lstat(name)
 		if err != nil {
-			return errorMsg(err)
+			return OperationResultMsg{Operation: DeleteOperation, Path: absolutePath(name), Err: err}
 		}
 
 		if fileInfo.IsDir() {
-			if err := dirfs.DeleteDirectory(name); err != nil {
-				return errorMsg(err)
-			}
+			err = dirfs.DeleteDirectory(name)
 		} else {
-			if err := dirfs.DeleteFile(name); err != nil {
-				return errorMsg(err)
-			}
+			err = dirfs.DeleteFile(name)
 		}
 
-		return nil
+		return OperationResultMsg{Operation: DeleteOperation, Path: absolutePath(name), Err: err}
 	}
 }
 
@@ -216,11 +276,10 @@ func copyToClipboardCmd(name string) tea.Cmd {
 // renameItemCmd renames a file or directory based on the name and value provided.
 func renameItemCmd(name, value string) tea.Cmd {
 	return func() tea.Msg {
-		if err := dirfs.RenameDirectoryItem(name, value); err != nil {
-			return errorMsg(err)
-		}
+		destination := absolutePath(value)
+		err := dirfs.RenameDirectoryItem(name, value)
 
-		return nil
+		return OperationResultMsg{Operation: RenameOperation, Path: absolutePath(name), Destination: destination, Err: err}
 	}
 }
 
@@ -0,0 +1,25 @@
+package filetree
+
+import (
+	"io"
+
+	"github.com/charmbracelet/bubbles/list"
+	"github.com/charmbracelet/lipgloss"
+)
+
+// itemDelegate wraps the default list delegate so that the
+// icon of the selected item can be rendered in its own color.
+type itemDelegate struct {
+	list.DefaultDelegate
+	selectedIconColor lipgloss.TerminalColor
+}
+
+// Render renders an item, overriding the icon color of the selected item if set.
+func (d itemDelegate) Render(w io.Writer, m list.Model, index int, listItem list.Item) {
+	if item, ok := listItem.(Item); ok && index == m.Index() && d.selectedIconColor != nil {
+		item.iconColor = d.selectedIconColor
+		listItem = item
+	}
+
+	d.DefaultDelegate.Render(w, m, index, listItem)
+}
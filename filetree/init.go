@@ -6,7 +6,8 @@ import (
 	"github.com/mistakenelf/teacup/dirfs"
 )
 
-// Init initializes the filetree with files from the current directory.
+// Init initializes the filetree, returning the command which loads the initial
+// listing of the start directory, or the current directory if no start directory is set.
 func (m Model) Init() tea.Cmd {
 	var (
 		cmd  tea.Cmd
@@ -12,6 +12,9 @@ import (
 // fileIconWidth represents the width of the file icons.
 const fileIconWidth = 2
 
+// brokenSymlinkMarker is shown before the title of broken symlinks.
+const brokenSymlinkMarker = "⚠"
+
 // Item represents a list item.
 type Item struct {
 	title            string
@@ -20,13 +23,22 @@ type Item struct {
 	shortName        string
 	extension        string
 	currentDirectory string
+	symlinkTarget    string
 	isDirectory      bool
+	isSymlink        bool
+	isBrokenSymlink  bool
 	showIcons        bool
 	fileInfo         fs.FileInfo
+	iconColor        lipgloss.TerminalColor
 }
 
 // Title returns the title of the list item.
 func (i Item) Title() string {
+	title := i.title
+	if i.isBrokenSymlink {
+		title = statusMessageErrorStyle(fmt.Sprintf("%s %s", brokenSymlinkMarker, i.title))
+	}
+
 	if i.fileInfo != nil {
 		icon, color := icons.GetIcon(
 			i.fileInfo.Name(),
@@ -35,14 +47,20 @@ func (i Item) Title() string {
 		)
 		fileIcon := lipgloss.NewStyle().Width(fileIconWidth).Render(fmt.Sprintf("%s%s\033[0m ", color, icon))
 
+		if i.iconColor != nil {
+			fileIcon = lipgloss.NewStyle().
+				Width(fileIconWidth).
+				Render(fmt.Sprintf("%s ", lipgloss.NewStyle().Foreground(i.iconColor).Render(icon)))
+		}
+
 		if i.showIcons {
-			return fmt.Sprintf("%s %s", fileIcon, i.title)
+			return fmt.Sprintf("%s %s", fileIcon, title)
 		}
 
-		return i.title
+		return title
 	}
 
-	return i.title
+	return title
 }
 
 // FileName returns the file name of the list item.
@@ -54,6 +72,15 @@ func (i Item) FileExtension() string { return i.extension }
 // IsDirectory returns true if the list item is a directory.
 func (i Item) IsDirectory() bool { return i.isDirectory }
 
+// IsSymlink returns true if the list item is a symlink.
+func (i Item) IsSymlink() bool { return i.isSymlink }
+
+// IsBrokenSymlink returns true if the list item is a symlink whose target does not exist.
+func (i Item) IsBrokenSymlink() bool { return i.isBrokenSymlink }
+
+// SymlinkTarget returns the target of the list item if it is a symlink.
+func (i Item) SymlinkTarget() string { return i.symlinkTarget }
+
 // Description returns the description of the list item.
 func (i Item) Description() string { return i.desc }
 
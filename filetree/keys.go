@@ -15,8 +15,11 @@ var (
 	homeShortcutKey    = key.NewBinding(key.WithKeys("~"), key.WithHelp("~", "go to home directory"))
 	rootShortcutKey    = key.NewBinding(key.WithKeys("R"), key.WithHelp("R", "go to root directory"))
 	copyToClipboardKey = key.NewBinding(key.WithKeys("y"), key.WithHelp("y", "copy path to clipboard"))
+	copyCurrentDirKey  = key.NewBinding(key.WithKeys("Y"), key.WithHelp("Y", "copy current directory to clipboard"))
+	currentDirInfoKey  = key.NewBinding(key.WithKeys("i"), key.WithHelp("i", "show current directory"))
 	renameItemKey      = key.NewBinding(key.WithKeys("r"), key.WithHelp("r", "rename item"))
 	openInEditorKey    = key.NewBinding(key.WithKeys("e"), key.WithHelp("e", "open in editor"))
 	moveItemKey        = key.NewBinding(key.WithKeys("m"), key.WithHelp("m", "move item"))
+	selectRegisterKey  = key.NewBinding(key.WithKeys("\""), key.WithHelp("\"", "select register"))
 	escapeKey          = key.NewBinding(key.WithKeys("esc"), key.WithHelp("esc", "reset to initial state"))
 )
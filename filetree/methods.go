@@ -2,11 +2,13 @@ package filetree
 
 import (
 	"fmt"
+	"path/filepath"
 
 	"github.com/charmbracelet/bubbles/list"
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/charmbracelet/lipgloss"
 	"github.com/mistakenelf/teacup/dirfs"
+	"github.com/muesli/reflow/truncate"
 )
 
 const (
@@ -54,6 +56,7 @@ func (m *Model) SetSize(width, height int) {
 		width-horizontal-vertical,
 		height-vertical-lipgloss.Height(m.input.View())-inputStyle.GetVerticalPadding(),
 	)
+	m.updateTitle()
 }
 
 // SetBorderColor sets the color of the border.
@@ -71,6 +74,11 @@ func (m Model) GetSelectedItem() Item {
 	return Item{}
 }
 
+// GetCurrentDirectory returns the directory currently being listed.
+func (m Model) GetCurrentDirectory() string {
+	return m.currentDir
+}
+
 // Cursor returns the current position of the cursor in the tree.
 func (m Model) Cursor() int {
 	return m.list.Index() + 1
@@ -103,11 +111,12 @@ func (m *Model) SetSelectionPath(path string) {
 
 // SetTitleColors sets the background and foreground of the title.
 func (m *Model) SetTitleColors(foreground, background lipgloss.AdaptiveColor) {
-	m.list.Styles.Title = m.list.Styles.Title.Copy().
+	m.titleStyle = m.titleStyle.Copy().
 		Bold(true).
 		Italic(true).
 		Background(background).
 		Foreground(foreground)
+	m.updateTitle()
 }
 
 // SetSelectedItemColors sets the foreground of the selected item.
@@ -120,6 +129,21 @@ func (m *Model) SetSelectedItemColors(foreground lipgloss.AdaptiveColor) {
 	m.list.SetDelegate(m.delegate)
 }
 
+// SetSelectedStyle sets the style applied to the selected item.
+func (m *Model) SetSelectedStyle(style lipgloss.Style) {
+	m.delegate.Styles.SelectedTitle = style
+	m.delegate.Styles.SelectedDesc = style.Copy()
+
+	m.list.SetDelegate(m.delegate)
+}
+
+// SetSelectedIconColor sets the color of the icon of the selected item.
+func (m *Model) SetSelectedIconColor(color lipgloss.AdaptiveColor) {
+	m.delegate.selectedIconColor = color
+
+	m.list.SetDelegate(m.delegate)
+}
+
 // SetBorderless sets weather or not to show the border.
 func (m *Model) SetBorderless(borderless bool) {
 	if borderless {
@@ -140,3 +164,57 @@ func (m *Model) ToggleShowIcons(showIcons bool) tea.Cmd {
 func (m *Model) ToggleHelp(showHelp bool) {
 	m.list.SetShowHelp(showHelp)
 }
+
+// SetPostOperationHook sets a function which is called after each create,
+// delete, move or rename completes, allowing follow up commands to be dispatched.
+func (m *Model) SetPostOperationHook(hook func(OperationResultMsg) tea.Cmd) {
+	m.postOperationHook = hook
+}
+
+// SetTitleMode sets how the title of the filetree is derived from the current directory.
+func (m *Model) SetTitleMode(mode TitleMode) {
+	m.titleMode = mode
+	m.updateTitle()
+}
+
+// updateTitle updates the title of the list based on the title mode and current directory.
+// The title bar itself is always shown as it also displays status messages.
+func (m *Model) updateTitle() {
+	m.list.Styles.Title = m.titleStyle
+
+	switch m.titleMode {
+	case StaticTitle:
+		m.list.Title = defaultTitle
+	case NoTitle:
+		m.list.Title = ""
+		m.list.Styles.Title = lipgloss.NewStyle()
+	case FullPathTitle:
+		m.list.Title = m.currentDir
+	case BaseNameTitle:
+		m.list.Title = filepath.Base(m.currentDir)
+
+		maxWidth := m.list.Width() - m.list.Styles.Title.GetHorizontalFrameSize()
+		if maxWidth > 0 {
+			m.list.Title = truncate.StringWithTail(m.list.Title, uint(maxWidth), "…")
+		}
+	}
+}
+
+// stageItem stores an item in the given register as well as the
+// unnamed register, waiting for it to be pasted.
+func (m *Model) stageItem(register string, item itemToMove) {
+	m.registers[register] = item
+	m.registers[UnnamedRegister] = item
+	m.state = moveItemState
+}
+
+// Registers returns the path of the item held in each register,
+// the default register is UnnamedRegister.
+func (m Model) Registers() map[string]string {
+	registers := make(map[string]string, len(m.registers))
+	for name, item := range m.registers {
+		registers[name] = item.path
+	}
+
+	return registers
+}
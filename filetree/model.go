@@ -4,6 +4,7 @@ import (
 	"github.com/charmbracelet/bubbles/key"
 	"github.com/charmbracelet/bubbles/list"
 	"github.com/charmbracelet/bubbles/textinput"
+	tea "github.com/charmbracelet/bubbletea"
 	"github.com/charmbracelet/lipgloss"
 )
 
@@ -18,9 +19,28 @@ const (
 	moveItemState
 )
 
+// TitleMode represents how the title of the filetree is derived.
+type TitleMode int
+
+// Available title modes.
+const (
+	StaticTitle TitleMode = iota
+	FullPathTitle
+	BaseNameTitle
+	NoTitle
+)
+
+// defaultTitle is the title shown when using StaticTitle.
+const defaultTitle = "Filetree"
+
+// UnnamedRegister is the register used when no register has been selected,
+// it always holds the most recently staged item.
+const UnnamedRegister = "\""
+
 type itemToMove struct {
 	shortName string
 	path      string
+	operation Operation
 }
 
 // Bubble represents the properties of a filetree.
@@ -35,8 +55,15 @@ type Model struct {
 	height        int
 	startDir      string
 	selectionPath string
-	itemToMove    itemToMove
-	delegate      list.DefaultDelegate
+	registers     map[string]itemToMove
+	register      string
+	selecting     bool
+	delegate      itemDelegate
+	titleMode     TitleMode
+	titleStyle    lipgloss.Style
+	currentDir    string
+
+	postOperationHook func(OperationResultMsg) tea.Cmd
 }
 
 // New creates a new instance of a filetree.
@@ -51,8 +78,10 @@ func New(
 		BorderLeftForeground(selectedItemColor)
 	listDelegate.Styles.SelectedDesc = listDelegate.Styles.SelectedTitle.Copy()
 
-	listModel := list.New([]list.Item{}, listDelegate, 0, 0)
-	listModel.Title = "Filetree"
+	delegate := itemDelegate{DefaultDelegate: listDelegate}
+
+	listModel := list.New([]list.Item{}, delegate, 0, 0)
+	listModel.Title = defaultTitle
 	listModel.Styles.Title = listModel.Styles.Title.Copy().
 		Bold(true).
 		Italic(true).
@@ -71,11 +100,14 @@ func New(
 			toggleHiddenKey,
 			homeShortcutKey,
 			copyToClipboardKey,
+			copyCurrentDirKey,
+			currentDirInfoKey,
 			escapeKey,
 			renameItemKey,
 			openInEditorKey,
 			submitInputKey,
 			moveItemKey,
+			selectRegisterKey,
 		}
 	}
 	listModel.AdditionalFullHelpKeys = func() []key.Binding {
@@ -90,11 +122,14 @@ func New(
 			toggleHiddenKey,
 			homeShortcutKey,
 			copyToClipboardKey,
+			copyCurrentDirKey,
+			currentDirInfoKey,
 			escapeKey,
 			renameItemKey,
 			openInEditorKey,
 			submitInputKey,
 			moveItemKey,
+			selectRegisterKey,
 		}
 	}
 
@@ -119,6 +154,9 @@ func New(
 		state:         idleState,
 		startDir:      startDir,
 		selectionPath: selectionPath,
-		delegate:      listDelegate,
+		delegate:      delegate,
+		titleStyle:    listModel.Styles.Title,
+		registers:     map[string]itemToMove{},
+		register:      UnnamedRegister,
 	}
 }
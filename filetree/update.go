@@ -12,6 +12,17 @@ const (
 	enterKey = "enter"
 )
 
+// isValidRegister returns true if the key can be used as a register name.
+func isValidRegister(msg tea.KeyMsg) bool {
+	if msg.Type != tea.KeyRunes || len(msg.Runes) != 1 || msg.Alt {
+		return false
+	}
+
+	r := msg.Runes[0]
+
+	return (r >= 'a' && r <= 'z') || (r >= '0' && r <= '9')
+}
+
 // Update handles updating the filetree.
 func (m Model) Update(msg tea.Msg) (Model, tea.Cmd) {
 	var cmd tea.Cmd
@@ -23,6 +34,11 @@ func (m Model) Update(msg tea.Msg) (Model, tea.Cmd) {
 		m.height = msg.Height
 	case getDirectoryListingMsg:
 		if msg != nil {
+			if item, ok := msg[0].(Item); ok {
+				m.currentDir = item.currentDirectory
+				m.updateTitle()
+			}
+
 			cmd = m.list.SetItems(msg)
 			cmds = append(cmds, cmd)
 		}
@@ -30,6 +46,16 @@ func (m Model) Update(msg tea.Msg) (Model, tea.Cmd) {
 		return m, m.list.NewStatusMessage(statusMessageInfoStyle(string(msg)))
 	case errorMsg:
 		return m, m.list.NewStatusMessage(statusMessageErrorStyle(msg.Error()))
+	case OperationResultMsg:
+		if msg.Err != nil {
+			cmds = append(cmds, m.list.NewStatusMessage(statusMessageErrorStyle(msg.Err.Error())))
+		}
+
+		if m.postOperationHook != nil {
+			cmds = append(cmds, m.postOperationHook(msg))
+		}
+
+		return m, tea.Batch(cmds...)
 	case tea.KeyMsg:
 		if m.IsFiltering() {
 			break
@@ -39,6 +65,28 @@ func (m Model) Update(msg tea.Msg) (Model, tea.Cmd) {
 			return m, nil
 		}
 
+		if m.selecting {
+			m.selecting = false
+
+			if key.Matches(msg, escapeKey) {
+				return m, nil
+			}
+
+			if !isValidRegister(msg) {
+				return m, m.list.NewStatusMessage(
+					statusMessageErrorStyle("Registers must be a single letter or number"),
+				)
+			}
+
+			m.register = msg.String()
+
+			return m, nil
+		}
+
+		// A selected register only applies to the key pressed directly after selecting it.
+		register := m.register
+		m.register = UnnamedRegister
+
 		switch m.state {
 		case deleteItemState:
 			if msg.String() == yesKey {
@@ -59,16 +107,35 @@ func (m Model) Update(msg tea.Msg) (Model, tea.Cmd) {
 			}
 		case moveItemState:
 			if msg.String() == enterKey {
-				statusCmd := m.list.NewStatusMessage(
-					statusMessageInfoStyle("Successfully moved item"),
-				)
+				item, ok := m.registers[register]
+				if !ok {
+					return m, m.list.NewStatusMessage(statusMessageErrorStyle("Register is empty"))
+				}
+
+				for name, registerItem := range m.registers {
+					if registerItem == item {
+						delete(m.registers, name)
+					}
+				}
+
+				pasteCmd := moveItemCmd(item.path, item.shortName)
+				statusMessage := "Successfully moved item"
+
+				if item.operation == CopyOperation {
+					pasteCmd = pasteCopyCmd(item.path, item.shortName)
+					statusMessage = "Successfully copied item"
+				}
+
+				statusCmd := m.list.NewStatusMessage(statusMessageInfoStyle(statusMessage))
 
 				cmds = append(cmds, statusCmd, tea.Sequence(
-					moveItemCmd(m.itemToMove.path, m.itemToMove.shortName),
+					pasteCmd,
 					getDirectoryListingCmd(dirfs.CurrentDirectory, m.showHidden, m.showIcons),
 				))
 
-				m.state = idleState
+				if len(m.registers) == 0 {
+					m.state = idleState
+				}
 
 				return m, tea.Batch(cmds...)
 			}
@@ -83,6 +150,17 @@ func (m Model) Update(msg tea.Msg) (Model, tea.Cmd) {
 		case key.Matches(msg, copyItemKey):
 			if !m.input.Focused() {
 				selectedItem := m.GetSelectedItem()
+
+				if register != UnnamedRegister {
+					m.stageItem(register, itemToMove{
+						shortName: selectedItem.shortName,
+						path:      selectedItem.fileName,
+						operation: CopyOperation,
+					})
+
+					return m, nil
+				}
+
 				statusCmd := m.list.NewStatusMessage(
 					statusMessageInfoStyle("Successfully copied file"),
 				)
@@ -141,11 +219,17 @@ func (m Model) Update(msg tea.Msg) (Model, tea.Cmd) {
 		case key.Matches(msg, moveItemKey):
 			if !m.input.Focused() {
 				selectedItem := m.GetSelectedItem()
-				m.state = moveItemState
-				m.itemToMove = itemToMove{
+				m.stageItem(register, itemToMove{
 					shortName: selectedItem.shortName,
 					path:      selectedItem.fileName,
-				}
+					operation: MoveOperation,
+				})
+
+				return m, nil
+			}
+		case key.Matches(msg, selectRegisterKey):
+			if !m.input.Focused() {
+				m.selecting = true
 
 				return m, nil
 			}
@@ -175,8 +259,30 @@ func (m Model) Update(msg tea.Msg) (Model, tea.Cmd) {
 				selectedItem := m.GetSelectedItem()
 				cmds = append(cmds, copyToClipboardCmd(selectedItem.fileName))
 			}
+		case key.Matches(msg, copyCurrentDirKey):
+			if !m.input.Focused() {
+				if m.GetCurrentDirectory() == "" {
+					return m, m.list.NewStatusMessage(
+						statusMessageErrorStyle("Current directory has not loaded yet"),
+					)
+				}
+
+				cmds = append(cmds, copyToClipboardCmd(m.GetCurrentDirectory()))
+			}
+		case key.Matches(msg, currentDirInfoKey):
+			if !m.input.Focused() {
+				if m.GetCurrentDirectory() == "" {
+					return m, m.list.NewStatusMessage(
+						statusMessageErrorStyle("Current directory has not loaded yet"),
+					)
+				}
+
+				return m, m.list.NewStatusMessage(statusMessageInfoStyle(m.GetCurrentDirectory()))
+			}
 		case key.Matches(msg, escapeKey):
 			m.state = idleState
+			m.registers = map[string]itemToMove{}
+			m.register = UnnamedRegister
 
 			if m.input.Focused() {
 				m.input.Reset()
@@ -198,6 +304,12 @@ func (m Model) Update(msg tea.Msg) (Model, tea.Cmd) {
 		case key.Matches(msg, submitInputKey):
 			selectedItem := m.GetSelectedItem()
 
+			if m.state == createFileState || m.state == createDirectoryState || m.state == renameItemState {
+				if err := dirfs.IsValidFilename(m.input.Value()); err != nil {
+					return m, m.list.NewStatusMessage(statusMessageErrorStyle(err.Error()))
+				}
+			}
+
 			switch m.state {
 			case idleState, deleteItemState, moveItemState:
 				return m, nil
@@ -20,7 +20,18 @@ func (m Model) View() string {
 	case deleteItemState:
 		inputView = "Are you sure you want to delete? (y/n)"
 	case moveItemState:
-		inputView = fmt.Sprintf("Currently moving %s", m.itemToMove.shortName)
+		item, ok := m.registers[UnnamedRegister]
+
+		switch {
+		case ok && item.operation == CopyOperation:
+			inputView = fmt.Sprintf("Currently copying %s", item.shortName)
+		case ok:
+			inputView = fmt.Sprintf("Currently moving %s", item.shortName)
+		case len(m.registers) == 1:
+			inputView = "1 item staged in registers"
+		default:
+			inputView = fmt.Sprintf("%d items staged in registers", len(m.registers))
+		}
 	default:
 		inputView = ""
 	}
@@ -4,6 +4,7 @@ package help
 
 import (
 	"fmt"
+	"strings"
 
 	"github.com/charmbracelet/bubbles/viewport"
 	tea "github.com/charmbracelet/bubbletea"
@@ -28,20 +29,25 @@ type Entry struct {
 
 // Model represents the properties of a help bubble.
 type Model struct {
-	Viewport    viewport.Model
-	Entries     []Entry
-	BorderColor lipgloss.AdaptiveColor
-	Title       string
-	TitleColor  TitleColor
-	Active      bool
-	Borderless  bool
+	Viewport     viewport.Model
+	Entries      []Entry
+	BorderColor  lipgloss.AdaptiveColor
+	Title        string
+	TitleColor   TitleColor
+	Active       bool
+	Borderless   bool
+	EntrySpacing int
 }
 
 // generateHelpScreen generates the help text based on the title and entries.
-func generateHelpScreen(title string, titleColor TitleColor, entries []Entry, width, height int) string {
+func generateHelpScreen(title string, titleColor TitleColor, entries []Entry, width, height, spacing int) string {
 	helpScreen := ""
 
-	for _, content := range entries {
+	for i, content := range entries {
+		if i > 0 && spacing > 0 {
+			helpScreen += strings.Repeat("\n", spacing)
+		}
+
 		keyText := lipgloss.NewStyle().
 			Bold(true).
 			Foreground(lipgloss.AdaptiveColor{Dark: "#ffffff", Light: "#000000"}).
@@ -99,7 +105,7 @@ func New(
 		Border(border).
 		BorderForeground(borderColor)
 
-	viewPort.SetContent(generateHelpScreen(title, titleColor, entries, 0, 0))
+	viewPort.SetContent(generateHelpScreen(title, titleColor, entries, 0, 0, 0))
 
 	return Model{
 		Viewport:    viewPort,
@@ -117,7 +123,9 @@ func (m *Model) SetSize(w, h int) {
 	m.Viewport.Width = w
 	m.Viewport.Height = h
 
-	m.Viewport.SetContent(generateHelpScreen(m.Title, m.TitleColor, m.Entries, m.Viewport.Width, m.Viewport.Height))
+	m.Viewport.SetContent(generateHelpScreen(
+		m.Title, m.TitleColor, m.Entries, m.Viewport.Width, m.Viewport.Height, m.EntrySpacing,
+	))
 }
 
 // SetBorderColor sets the current color of the border.
@@ -139,7 +147,18 @@ func (m *Model) GotoTop() {
 func (m *Model) SetTitleColor(color TitleColor) {
 	m.TitleColor = color
 
-	m.Viewport.SetContent(generateHelpScreen(m.Title, m.TitleColor, m.Entries, m.Viewport.Width, m.Viewport.Height))
+	m.Viewport.SetContent(generateHelpScreen(
+		m.Title, m.TitleColor, m.Entries, m.Viewport.Width, m.Viewport.Height, m.EntrySpacing,
+	))
+}
+
+// SetEntrySpacing sets the number of blank lines between entries.
+func (m *Model) SetEntrySpacing(spacing int) {
+	m.EntrySpacing = spacing
+
+	m.Viewport.SetContent(generateHelpScreen(
+		m.Title, m.TitleColor, m.Entries, m.Viewport.Width, m.Viewport.Height, m.EntrySpacing,
+	))
 }
 
 // SetBorderless sets weather or not to show the border.
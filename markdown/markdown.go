@@ -4,21 +4,44 @@ package markdown
 
 import (
 	"errors"
+	"regexp"
+	"strings"
 
 	"github.com/charmbracelet/bubbles/viewport"
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/charmbracelet/glamour"
 	"github.com/charmbracelet/lipgloss"
 	"github.com/mistakenelf/teacup/dirfs"
+	"github.com/yuin/goldmark"
+	"github.com/yuin/goldmark/ast"
+	"github.com/yuin/goldmark/text"
 )
 
-type renderMarkdownMsg string
+type renderMarkdownMsg struct {
+	content  string
+	headings []Heading
+}
 type errorMsg error
 
 const (
 	padding = 1
 )
 
+var ansiPattern = regexp.MustCompile(`\x1b\[[0-9;]*[a-zA-Z]`)
+
+// Heading represents a single heading found within the markdown content.
+type Heading struct {
+	Level int
+	Text  string
+	Line  int
+}
+
+// HeadingsMsg is sent whenever the markdown content is rendered,
+// containing the headings which can be used to build an outline.
+type HeadingsMsg struct {
+	Headings []Heading
+}
+
 // Model represents the properties of a code bubble.
 type Model struct {
 	Viewport    viewport.Model
@@ -27,20 +50,34 @@ type Model struct {
 	Borderless  bool
 	FileName    string
 	ImageString string
+	Headings    []Heading
+	HardWraps   bool
 }
 
 // RenderMarkdown renders the markdown content with glamour.
 func RenderMarkdown(width int, content string) (string, error) {
+	return renderMarkdown(width, content, false)
+}
+
+// renderMarkdown renders the markdown content with glamour,
+// preserving single newlines as line breaks if hardWraps is set.
+func renderMarkdown(width int, content string, hardWraps bool) (string, error) {
 	background := "light"
 
 	if lipgloss.HasDarkBackground() {
 		background = "dark"
 	}
 
-	r, _ := glamour.NewTermRenderer(
+	options := []glamour.TermRendererOption{
 		glamour.WithWordWrap(width),
 		glamour.WithStandardStyle(background),
-	)
+	}
+
+	if hardWraps {
+		options = append(options, glamour.WithPreservedNewLines())
+	}
+
+	r, _ := glamour.NewTermRenderer(options...)
 
 	out, err := r.Render(content)
 	if err != nil {
@@ -50,20 +87,120 @@ func RenderMarkdown(width int, content string) (string, error) {
 	return out, nil
 }
 
+// getHeadings returns the headings of the markdown content, excluding any within
+// code blocks, along with the line they appear on in the rendered output.
+func getHeadings(content, rendered string) []Heading {
+	var (
+		headings     []Heading
+		matchTexts   []string
+		source       = []byte(content)
+		document     = goldmark.DefaultParser().Parse(text.NewReader(source))
+		renderedText = ansiPattern.ReplaceAllString(rendered, "")
+	)
+
+	_ = ast.Walk(document, func(node ast.Node, entering bool) (ast.WalkStatus, error) {
+		if heading, ok := node.(*ast.Heading); ok && entering {
+			headings = append(headings, Heading{
+				Level: heading.Level,
+				Text:  normalizeWhitespace(string(heading.Text(source))),
+			})
+			matchTexts = append(matchTexts, renderedHeadingText(heading, source))
+
+			return ast.WalkSkipChildren, nil
+		}
+
+		return ast.WalkContinue, nil
+	})
+
+	renderedLines := strings.Split(renderedText, "\n")
+	for i, line := range renderedLines {
+		renderedLines[i] = normalizeWhitespace(strings.TrimLeft(strings.TrimSpace(line), "#"))
+	}
+
+	start := 0
+
+	for i := range headings {
+		line := findHeadingLine(renderedLines, matchTexts[i], start)
+		if line == -1 {
+			headings[i].Line = start
+
+			continue
+		}
+
+		headings[i].Line = line
+		start = line + 1
+	}
+
+	return headings
+}
+
+// renderedHeadingText returns the text of a heading as glamour renders it,
+// which is its plain text with the destination following any links.
+func renderedHeadingText(heading *ast.Heading, source []byte) string {
+	var builder strings.Builder
+
+	_ = ast.Walk(heading, func(node ast.Node, entering bool) (ast.WalkStatus, error) {
+		switch node := node.(type) {
+		case *ast.Text:
+			if entering {
+				builder.Write(node.Segment.Value(source))
+			}
+		case *ast.String:
+			if entering {
+				builder.Write(node.Value)
+			}
+		case *ast.Link:
+			if !entering {
+				builder.WriteString(" " + string(node.Destination))
+			}
+		}
+
+		return ast.WalkContinue, nil
+	})
+
+	return normalizeWhitespace(builder.String())
+}
+
+// normalizeWhitespace trims text and collapses any runs of whitespace within it.
+func normalizeWhitespace(text string) string {
+	return strings.Join(strings.Fields(text), " ")
+}
+
+// findHeadingLine returns the first line from start which is the heading text, falling
+// back to the first line which starts the heading text for headings which have wrapped.
+func findHeadingLine(lines []string, text string, start int) int {
+	for i := start; i < len(lines); i++ {
+		if lines[i] == text {
+			return i
+		}
+	}
+
+	for i := start; i < len(lines); i++ {
+		if lines[i] != "" && strings.HasPrefix(text, lines[i]) {
+			return i
+		}
+	}
+
+	return -1
+}
+
 // renderMarkdownCmd renders text as pretty markdown.
-func renderMarkdownCmd(width int, filename string) tea.Cmd {
+func renderMarkdownCmd(width int, filename string, hardWraps bool) tea.Cmd {
 	return func() tea.Msg {
 		content, err := dirfs.ReadFileContent(filename)
 		if err != nil {
 			return errorMsg(err)
 		}
 
-		markdownContent, err := RenderMarkdown(width, content)
+		markdownContent, err := renderMarkdown(width, content, hardWraps)
 		if err != nil {
 			return errorMsg(err)
 		}
 
-		return renderMarkdownMsg(markdownContent)
+		return renderMarkdownMsg{
+			content:  markdownContent,
+			headings: getHeadings(content, markdownContent),
+		}
 	}
 }
 
@@ -100,7 +237,7 @@ func (m Model) Init() tea.Cmd {
 func (m *Model) SetFileName(filename string) tea.Cmd {
 	m.FileName = filename
 
-	return renderMarkdownCmd(m.Viewport.Width, filename)
+	return renderMarkdownCmd(m.Viewport.Width, filename, m.HardWraps)
 }
 
 // SetBorderColor sets the current color of the border.
@@ -126,7 +263,19 @@ func (m *Model) SetSize(w, h int) tea.Cmd {
 		BorderForeground(m.BorderColor)
 
 	if m.FileName != "" {
-		return renderMarkdownCmd(m.Viewport.Width, m.FileName)
+		return renderMarkdownCmd(m.Viewport.Width, m.FileName, m.HardWraps)
+	}
+
+	return nil
+}
+
+// SetHardWraps sets weather or not single newlines are rendered as line breaks,
+// this returns a cmd which will re-render the current file.
+func (m *Model) SetHardWraps(hardWraps bool) tea.Cmd {
+	m.HardWraps = hardWraps
+
+	if m.FileName != "" {
+		return renderMarkdownCmd(m.Viewport.Width, m.FileName, m.HardWraps)
 	}
 
 	return nil
@@ -147,6 +296,15 @@ func (m *Model) SetIsActive(active bool) {
 	m.Active = active
 }
 
+// ScrollToHeading scrolls the viewport to the heading at the given index.
+func (m *Model) ScrollToHeading(index int) {
+	if index < 0 || index >= len(m.Headings) {
+		return
+	}
+
+	m.Viewport.SetYOffset(m.Headings[index].Line)
+}
+
 // Update handles updating the UI of a code bubble.
 func (m Model) Update(msg tea.Msg) (Model, tea.Cmd) {
 	var (
@@ -159,16 +317,22 @@ func (m Model) Update(msg tea.Msg) (Model, tea.Cmd) {
 		content := lipgloss.NewStyle().
 			Width(m.Viewport.Width).
 			Height(m.Viewport.Height).
-			Render(string(msg))
+			Render(msg.content)
 
 		m.Viewport.SetContent(content)
+		m.Headings = msg.headings
 
-		return m, nil
+		return m, func() tea.Msg {
+			return HeadingsMsg{Headings: msg.headings}
+		}
 	case errorMsg:
 		m.FileName = ""
+		m.Headings = nil
 		m.Viewport.SetContent(msg.Error())
 
-		return m, nil
+		return m, func() tea.Msg {
+			return HeadingsMsg{}
+		}
 	}
 
 	if m.Active {
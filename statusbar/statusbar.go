@@ -72,6 +72,12 @@ func (m *Model) SetColors(firstColumnColors, secondColumnColors, thirdColumnColo
 	m.FourthColumnColors = fourthColumnColors
 }
 
+// RenderedWidth returns the width the statusbar occupies when rendered, this is
+// the set width unless the content of the columns is wider than it.
+func (m Model) RenderedWidth() int {
+	return lipgloss.Width(m.View())
+}
+
 // View returns a string representation of a statusbar.
 func (m Model) View() string {
 	width := lipgloss.Width